@@ -0,0 +1,63 @@
+package v1alpha1
+
+// InterpreterOperation is the set of operations the resource interpreter (whether backed by a
+// webhook or a WASM module) may be asked to perform for a given resource.
+type InterpreterOperation string
+
+const (
+	// InterpreterOperationInterpretReplica indicates the interpreter returns the replica
+	// declaration of the object.
+	InterpreterOperationInterpretReplica InterpreterOperation = "InterpretReplica"
+
+	// InterpreterOperationPatch indicates the interpreter returns a patch to be applied on the
+	// object before it is propagated to member clusters.
+	InterpreterOperationPatch InterpreterOperation = "Patch"
+
+	// InterpreterOperationInterpretDependency indicates the interpreter returns the dependencies
+	// of the object.
+	InterpreterOperationInterpretDependency InterpreterOperation = "Dependencies"
+
+	// InterpreterOperationReflectStatus indicates the interpreter returns the status that should
+	// be collected from the object.
+	InterpreterOperationReflectStatus InterpreterOperation = "ReflectStatus"
+
+	// InterpreterOperationInterpretHealth indicates the interpreter returns the health state of
+	// the object.
+	InterpreterOperationInterpretHealth InterpreterOperation = "InterpretHealth"
+
+	// InterpreterOperationAll matches all operations. Services that interpret all operations for
+	// a given resource can use this instead of listing them all out.
+	InterpreterOperationAll InterpreterOperation = "*"
+)
+
+// DependentObjectReference points to a resource which is depended on by another resource, e.g. a
+// ConfigMap referenced by a Deployment's volume.
+type DependentObjectReference struct {
+	// APIVersion of the referent.
+	APIVersion string `json:"apiVersion"`
+
+	// Kind of the referent.
+	Kind string `json:"kind"`
+
+	// Namespace of the referent. Empty for cluster-scoped referents.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name of the referent.
+	Name string `json:"name"`
+}
+
+// PatchType defines the mechanism used to apply a patch returned by an interpreter.
+type PatchType string
+
+const (
+	// PatchTypeJSONPatch is a JSON Patch as defined in https://datatracker.ietf.org/doc/html/rfc6902.
+	PatchTypeJSONPatch PatchType = "JSONPatch"
+
+	// PatchTypeMergePatch is a JSON Merge Patch as defined in https://datatracker.ietf.org/doc/html/rfc7396.
+	PatchTypeMergePatch PatchType = "MergePatch"
+
+	// PatchTypeStrategicMergePatch is a Kubernetes strategic merge patch, see
+	// https://kubernetes.io/docs/tasks/manage-kubernetes-objects/update-api-object-kubectl-patch/#use-a-strategic-merge-patch-to-update-a-deployment.
+	PatchTypeStrategicMergePatch PatchType = "StrategicMergePatch"
+)
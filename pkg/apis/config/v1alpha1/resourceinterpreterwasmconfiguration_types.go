@@ -0,0 +1,109 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope="Cluster"
+// +kubebuilder:object:root=true
+
+// ResourceInterpreterWasmConfiguration describes the WASM modules that take the responsibility to
+// tell Karmada the details of the resource object, especially for those CRD resources.
+// It is parallel to ResourceInterpreterWebhookConfiguration, but instead of calling out to a webhook
+// server it dispatches to a compiled WebAssembly module, which is often cheaper to run and easier
+// to distribute than standing up a webhook deployment.
+type ResourceInterpreterWasmConfiguration struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object's metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Modules is a list of modules and the corresponding interpreter rules.
+	// +optional
+	Modules []ResourceInterpreterWasmModule `json:"modules,omitempty"`
+}
+
+// ResourceInterpreterWasmModule describes a single WASM module along with the rules that tell
+// Karmada which resources and operations it is able to interpret.
+type ResourceInterpreterWasmModule struct {
+	// Name is the identifier of the module, it must be unique among all modules declared by this
+	// configuration.
+	Name string `json:"name"`
+
+	// Source represents the source where the module bytes can be fetched from. Only URL-based
+	// sources are currently supported; OCI and ConfigMap-based sources are not implemented yet.
+	Source WasmModuleSource `json:"source"`
+
+	// Rules describes what operations on what resources/subresources the module implements.
+	// The Webhook call if at least one Rule matches a request, then Karmada will dispatch the
+	// request to the module. The relationship mirrors ResourceInterpreterWebhook.Rules.
+	Rules []RuleWithWasmOperations `json:"rules,omitempty"`
+
+	// Limits bounds the resources a single invocation of this module may consume. Untrusted,
+	// user-provided modules must not be able to hang or runaway the controller.
+	// +optional
+	Limits *WasmModuleLimits `json:"limits,omitempty"`
+}
+
+// WasmModuleLimits bounds the resources a single WASM module invocation may consume.
+type WasmModuleLimits struct {
+	// Timeout is the wall-clock budget given to a single invocation. Exceeding it aborts the
+	// call with ErrInterpreterTimeout. Defaults to a conservative built-in value when unset.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// FuelLimit caps the number of instructions a single invocation may execute, enforced via the
+	// runtime's metering support. Exceeding it aborts the call with ErrInterpreterOutOfFuel.
+	// Runtimes without metering support, including the default wazero runtime, do not enforce it.
+	// Defaults to a conservative built-in value when unset.
+	// +optional
+	FuelLimit *uint64 `json:"fuelLimit,omitempty"`
+}
+
+// WasmModuleSource represents the source of a WASM module.
+//
+// OCI and ConfigMap sources are intentionally not part of this type yet: both need real fetch
+// implementations (an OCI registry client with auth, a ConfigMap lookup against the member
+// cluster's API) before they can be exposed as something a user can select, and neither exists
+// yet. Add them here together with the matching fetch logic in fetchModuleBytes when they do.
+type WasmModuleSource struct {
+	// URL carries the address the module binary can be fetched from, e.g. via HTTPS.
+	// +optional
+	URL *string `json:"url,omitempty"`
+}
+
+// RuleWithWasmOperations is a tuple of Operations and Resources, it tells the object/operation
+// a WASM module can handle. It is the WASM analogue of RuleWithOperations used by
+// ResourceInterpreterWebhookConfiguration.
+type RuleWithWasmOperations struct {
+	// Operations is the list of operations the module implements, e.g. InterpretReplica, Patch.
+	// '*' means all operations.
+	Operations []InterpreterOperation `json:"operations,omitempty"`
+
+	// APIGroups is the API groups the resources belong to. '*' means all groups.
+	APIGroups []string `json:"apiGroups,omitempty"`
+
+	// APIVersions is the API versions the resources belong to. '*' means all versions.
+	APIVersions []string `json:"apiVersions,omitempty"`
+
+	// Kinds is a list of resource kinds this rule applies to. '*' means all kinds.
+	Kinds []string `json:"kinds,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:object:root=true
+
+// ResourceInterpreterWasmConfigurationList is a collection of ResourceInterpreterWasmConfiguration.
+type ResourceInterpreterWasmConfigurationList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ResourceInterpreterWasmConfiguration.
+	Items []ResourceInterpreterWasmConfiguration `json:"items"`
+}
+
@@ -0,0 +1,58 @@
+package wasminterpreter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customizedinterpreter/webhook"
+)
+
+// callInterpreter implements the guest ABI documented for modules built against the
+// pkg/resourceinterpreter/wasminterpreter/sdk package: the host JSON-encodes attributes into the
+// instance's linear memory using the exported alloc(size)/dealloc(ptr, size) functions, calls
+// Interpreter(ptr, len) which returns a packed i64 of (respPtr<<32 | respLen), reads the response
+// back out of memory, and unmarshals it. Runtimes can only pass numeric types across the
+// boundary, so attributes/response can never cross it as Go values directly.
+func callInterpreter(ctx context.Context, instance Instance, attributes *webhook.RequestAttributes) (*webhook.ResponseAttributes, error) {
+	reqBytes, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("encode request attributes: %w", err)
+	}
+
+	memory := instance.Memory()
+	if memory == nil {
+		return nil, fmt.Errorf("module does not export memory")
+	}
+
+	reqPtrResult, err := instance.Call(ctx, "alloc", uint64(len(reqBytes)))
+	if err != nil {
+		return nil, fmt.Errorf("alloc request buffer: %w", err)
+	}
+	reqPtr := uint32(reqPtrResult[0])
+	defer instance.Call(ctx, "dealloc", uint64(reqPtr), uint64(len(reqBytes))) //nolint:errcheck
+
+	if !memory.Write(reqPtr, reqBytes) {
+		return nil, fmt.Errorf("write request attributes: out of bounds at offset %d", reqPtr)
+	}
+
+	packedResult, err := instance.Call(ctx, "Interpreter", uint64(reqPtr), uint64(len(reqBytes)))
+	if err != nil {
+		return nil, fmt.Errorf("invoke Interpreter: %w", err)
+	}
+	packed := packedResult[0]
+	respPtr := uint32(packed >> 32)
+	respLen := uint32(packed)
+	defer instance.Call(ctx, "dealloc", uint64(respPtr), uint64(respLen)) //nolint:errcheck
+
+	respBytes, ok := memory.Read(respPtr, respLen)
+	if !ok {
+		return nil, fmt.Errorf("read response attributes: out of bounds at offset %d, length %d", respPtr, respLen)
+	}
+
+	response := &webhook.ResponseAttributes{}
+	if err := json.Unmarshal(respBytes, response); err != nil {
+		return nil, fmt.Errorf("decode response attributes: %w", err)
+	}
+	return response, nil
+}
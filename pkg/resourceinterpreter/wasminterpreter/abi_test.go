@@ -0,0 +1,119 @@
+package wasminterpreter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customizedinterpreter/webhook"
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/wasminterpreter/sdk"
+)
+
+// fakeGuestInstance is an in-process stand-in for a compiled module's Instance. It implements the
+// alloc/dealloc/Interpreter contract documented in abi.go by decoding/encoding with the sdk
+// package's own types, exactly as a real tinygo-built module would via sdk.Interpreter. Running it
+// in-process (rather than compiling an actual .wasm module) is what lets this test run without the
+// tinygo toolchain while still exercising the real wire format between host and guest, which is
+// what would catch a field drifting out of sync between webhook.RequestAttributes/
+// ResponseAttributes and their sdk mirrors.
+type fakeGuestInstance struct {
+	mem []byte
+}
+
+func (f *fakeGuestInstance) Call(_ context.Context, fn string, args ...uint64) ([]uint64, error) {
+	switch fn {
+	case "alloc":
+		ptr := uint32(len(f.mem))
+		f.mem = append(f.mem, make([]byte, args[0])...)
+		return []uint64{uint64(ptr)}, nil
+	case "dealloc":
+		return nil, nil
+	case "Interpreter":
+		ptr, size := uint32(args[0]), uint32(args[1])
+		var req sdk.RequestAttributes
+		if err := json.Unmarshal(f.mem[ptr:ptr+size], &req); err != nil {
+			return nil, err
+		}
+
+		resp := &sdk.ResponseAttributes{Error: fmt.Sprintf("no handler for operation %q", req.Operation)}
+		if req.Operation == sdk.OperationInterpretReplica {
+			resp = &sdk.ResponseAttributes{Replicas: 3}
+		}
+
+		data, err := json.Marshal(resp)
+		if err != nil {
+			return nil, err
+		}
+		respPtr := uint32(len(f.mem))
+		f.mem = append(f.mem, data...)
+		return []uint64{uint64(respPtr)<<32 | uint64(len(data))}, nil
+	default:
+		return nil, fmt.Errorf("unexpected call %q", fn)
+	}
+}
+
+func (f *fakeGuestInstance) Memory() Memory              { return fakeGuestMemory{f} }
+func (f *fakeGuestInstance) SetFuel(uint64)              {}
+func (f *fakeGuestInstance) FuelExhausted() bool         { return false }
+func (f *fakeGuestInstance) Close(context.Context) error { return nil }
+
+type fakeGuestMemory struct{ instance *fakeGuestInstance }
+
+func (m fakeGuestMemory) Read(offset, length uint32) ([]byte, bool) {
+	if uint64(offset)+uint64(length) > uint64(len(m.instance.mem)) {
+		return nil, false
+	}
+	out := make([]byte, length)
+	copy(out, m.instance.mem[offset:offset+length])
+	return out, true
+}
+
+func (m fakeGuestMemory) Write(offset uint32, data []byte) bool {
+	if uint64(offset)+uint64(len(data)) > uint64(len(m.instance.mem)) {
+		return false
+	}
+	copy(m.instance.mem[offset:], data)
+	return true
+}
+
+// TestCallInterpreterRoundTrip proves the host (callInterpreter) and a guest built against the sdk
+// package agree on the wire format: a request built from webhook.RequestAttributes must decode
+// correctly into sdk.RequestAttributes, and a response encoded as sdk.ResponseAttributes must
+// decode correctly back into webhook.ResponseAttributes.
+func TestCallInterpreterRoundTrip(t *testing.T) {
+	attributes := &webhook.RequestAttributes{
+		Operation: configv1alpha1.InterpreterOperationInterpretReplica,
+		GVK:       schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+		Object: &unstructured.Unstructured{Object: map[string]interface{}{
+			"spec": map[string]interface{}{"replicas": int64(3)},
+		}},
+	}
+
+	resp, err := callInterpreter(context.Background(), &fakeGuestInstance{}, attributes)
+	if err != nil {
+		t.Fatalf("callInterpreter() error = %v", err)
+	}
+	if resp.Replicas != 3 {
+		t.Errorf("resp.Replicas = %d, want 3", resp.Replicas)
+	}
+}
+
+func TestCallInterpreterRoundTrip_UnmatchedOperation(t *testing.T) {
+	attributes := &webhook.RequestAttributes{
+		Operation: configv1alpha1.InterpreterOperationPatch,
+		GVK:       schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+	}
+
+	resp, err := callInterpreter(context.Background(), &fakeGuestInstance{}, attributes)
+	if err != nil {
+		t.Fatalf("callInterpreter() error = %v", err)
+	}
+	if resp.Replicas != 0 {
+		t.Errorf("resp.Replicas = %d, want 0 for an operation the fake guest doesn't implement", resp.Replicas)
+	}
+}
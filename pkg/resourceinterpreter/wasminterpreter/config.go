@@ -0,0 +1,243 @@
+package wasminterpreter
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
+)
+
+// defaultTimeout and defaultFuelLimit apply to modules that don't declare Limits, so that an
+// untrusted module can never hang or runaway the controller just by omitting the field.
+const (
+	defaultTimeout   = 3 * time.Second
+	defaultFuelLimit = uint64(50_000_000)
+)
+
+// moduleLimits is the resolved (i.e. defaulted) form of configv1alpha1.WasmModuleLimits.
+type moduleLimits struct {
+	Timeout   time.Duration
+	FuelLimit uint64
+}
+
+func resolveModuleLimits(limits *configv1alpha1.WasmModuleLimits) moduleLimits {
+	resolved := moduleLimits{Timeout: defaultTimeout, FuelLimit: defaultFuelLimit}
+	if limits == nil {
+		return resolved
+	}
+	if limits.Timeout != nil {
+		resolved.Timeout = limits.Timeout.Duration
+	}
+	if limits.FuelLimit != nil {
+		resolved.FuelLimit = *limits.FuelLimit
+	}
+	return resolved
+}
+
+// errNotUnstructured is returned when the informer hands back an object that is not backed by
+// unstructured content, which should never happen for a dynamic/generic informer.
+var errNotUnstructured = errors.New("object is not unstructured")
+
+// resourceInterpreterWasmConfigurationsGVR is the GVR of the CRD this package watches for module
+// discovery.
+var resourceInterpreterWasmConfigurationsGVR = schema.GroupVersionResource{
+	Group:    configv1alpha1.GroupName,
+	Version:  configv1alpha1.SchemeGroupVersion.Version,
+	Resource: "resourceinterpreterwasmconfigurations",
+}
+
+// registeredModule is a single module together with the rules that were declared for it, indexed
+// for fast lookup by interpret(). bytes/hash are resolved once per refresh so that interpret()
+// never has to re-fetch the module source on the hot path.
+type registeredModule struct {
+	name   string
+	hash   string
+	bytes  []byte
+	rules  []configv1alpha1.RuleWithWasmOperations
+	limits moduleLimits
+}
+
+// configRegistryQueueKey is the single key enqueued for every Add/Update/Delete event. The
+// registry always rebuilds its full state from the lister rather than reconciling a particular
+// object, so there is nothing to gain from keying the queue per-object; a single key also lets the
+// workqueue coalesce a burst of events into one refresh.
+const configRegistryQueueKey = "resourceinterpreterwasmconfigurations"
+
+// configRegistry keeps the set of WASM modules currently declared across all
+// ResourceInterpreterWasmConfiguration objects in the cluster, refreshed by watching the CRD via
+// the informer manager passed to NewWasmInterpreter.
+type configRegistry struct {
+	lister  cache.GenericLister
+	cache   *moduleCache
+	modules atomic.Value // []registeredModule
+
+	// queue decouples refresh from the informer's event-handler goroutine. refresh fetches module
+	// bytes over the network (see fetchModuleBytes), and a slow or unreachable source must never
+	// block delivery of events to the informer's other handlers.
+	queue workqueue.RateLimitingInterface
+}
+
+func newConfigRegistry(informer genericmanager.SingleClusterInformerManager, mc *moduleCache) *configRegistry {
+	r := &configRegistry{
+		cache: mc,
+		queue: workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter()),
+	}
+	r.modules.Store([]registeredModule{})
+
+	informer.ForResource(resourceInterpreterWasmConfigurationsGVR, cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { r.enqueueRefresh() },
+		UpdateFunc: func(interface{}, interface{}) { r.enqueueRefresh() },
+		DeleteFunc: func(interface{}) { r.enqueueRefresh() },
+	})
+	r.lister = informer.Lister(resourceInterpreterWasmConfigurationsGVR)
+	informer.Start()
+
+	go r.worker()
+
+	return r
+}
+
+// enqueueRefresh schedules a refresh on the worker goroutine instead of running it inline.
+func (r *configRegistry) enqueueRefresh() {
+	r.queue.Add(configRegistryQueueKey)
+}
+
+// worker drains the queue until it is shut down, running refresh on the calling (dedicated)
+// goroutine so informer event handlers never block on it.
+func (r *configRegistry) worker() {
+	for r.processNextQueueItem() {
+	}
+}
+
+func (r *configRegistry) processNextQueueItem() bool {
+	key, shutdown := r.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer r.queue.Done(key)
+
+	r.refresh()
+	r.queue.Forget(key)
+	return true
+}
+
+// refresh rebuilds the registry from scratch, fetching and hashing each module's bytes so that
+// interpret() can dispatch straight to moduleCache.
+func (r *configRegistry) refresh() {
+	objs, err := r.lister.List(labels.Everything())
+	if err != nil {
+		klog.Errorf("failed to list ResourceInterpreterWasmConfigurations: %v", err)
+		return
+	}
+
+	modules := make([]registeredModule, 0, len(objs))
+	live := make(map[string]struct{}, len(objs))
+	for _, obj := range objs {
+		config, err := toWasmConfiguration(obj)
+		if err != nil {
+			klog.Errorf("failed to decode ResourceInterpreterWasmConfiguration: %v", err)
+			continue
+		}
+		for _, m := range config.Modules {
+			ctx, cancel := context.WithTimeout(context.Background(), moduleFetchTimeout)
+			wasmBytes, err := fetchModuleBytes(ctx, m.Source)
+			cancel()
+			if err != nil {
+				klog.Errorf("failed to fetch wasm module %q: %v", m.Name, err)
+				continue
+			}
+
+			limits := resolveModuleLimits(m.Limits)
+			if m.Limits != nil && m.Limits.FuelLimit != nil && !r.cache.supportsFuelMetering() {
+				klog.Warningf("module %q declares a fuel limit but the active wasm runtime does not support fuel metering; it will not be enforced", m.Name)
+			}
+
+			sum := sha256.Sum256(wasmBytes)
+			hash := hex.EncodeToString(sum[:])
+			modules = append(modules, registeredModule{
+				name:   m.Name,
+				hash:   hash,
+				bytes:  wasmBytes,
+				rules:  m.Rules,
+				limits: limits,
+			})
+			live[hash] = struct{}{}
+		}
+	}
+
+	r.modules.Store(modules)
+	r.cache.retain(live)
+}
+
+// lookup returns the module registered to handle the given GVK/operation, if any.
+func (r *configRegistry) lookup(objGVK schema.GroupVersionKind, operation configv1alpha1.InterpreterOperation) (registeredModule, bool) {
+	for _, m := range r.modules.Load().([]registeredModule) {
+		for _, rule := range m.rules {
+			if ruleMatches(rule, objGVK, operation) {
+				return m, true
+			}
+		}
+	}
+	return registeredModule{}, false
+}
+
+// enabled reports whether any registered rule could serve the given GVK/operation.
+func (r *configRegistry) enabled(objGVK schema.GroupVersionKind, operation configv1alpha1.InterpreterOperation) bool {
+	_, ok := r.lookup(objGVK, operation)
+	return ok
+}
+
+func ruleMatches(rule configv1alpha1.RuleWithWasmOperations, objGVK schema.GroupVersionKind, operation configv1alpha1.InterpreterOperation) bool {
+	if !stringSliceMatches(rule.APIGroups, objGVK.Group) {
+		return false
+	}
+	if !stringSliceMatches(rule.APIVersions, objGVK.Version) {
+		return false
+	}
+	if !stringSliceMatches(rule.Kinds, objGVK.Kind) {
+		return false
+	}
+
+	for _, op := range rule.Operations {
+		if op == configv1alpha1.InterpreterOperationAll || op == operation {
+			return true
+		}
+	}
+	return false
+}
+
+func stringSliceMatches(values []string, want string) bool {
+	for _, v := range values {
+		if v == "*" || v == want {
+			return true
+		}
+	}
+	return false
+}
+
+// toWasmConfiguration converts the unstructured object handed to us by the informer into a typed
+// ResourceInterpreterWasmConfiguration.
+func toWasmConfiguration(obj runtime.Object) (*configv1alpha1.ResourceInterpreterWasmConfiguration, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return nil, errNotUnstructured
+	}
+	config := &configv1alpha1.ResourceInterpreterWasmConfiguration{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
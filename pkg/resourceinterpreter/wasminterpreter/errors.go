@@ -0,0 +1,13 @@
+package wasminterpreter
+
+import "errors"
+
+var (
+	// ErrInterpreterTimeout is returned when a module call is aborted for exceeding its
+	// configured wall-clock timeout.
+	ErrInterpreterTimeout = errors.New("wasm interpreter exceeded its execution timeout")
+
+	// ErrInterpreterOutOfFuel is returned when a module call is aborted for exhausting its
+	// configured fuel (instruction count) ceiling.
+	ErrInterpreterOutOfFuel = errors.New("wasm interpreter exhausted its fuel limit")
+)
@@ -0,0 +1,22 @@
+package wasminterpreter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	interpreterTimeoutsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "karmada_wasm_interpreter_timeouts_total",
+		Help: "Number of WASM interpreter calls aborted for exceeding their execution timeout, by module name.",
+	}, []string{"module"})
+
+	interpreterOutOfFuelTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "karmada_wasm_interpreter_out_of_fuel_total",
+		Help: "Number of WASM interpreter calls aborted for exhausting their fuel limit, by module name.",
+	}, []string{"module"})
+)
+
+func init() {
+	metrics.Registry.MustRegister(interpreterTimeoutsTotal, interpreterOutOfFuelTotal)
+}
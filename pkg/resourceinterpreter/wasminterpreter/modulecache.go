@@ -0,0 +1,188 @@
+package wasminterpreter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	"github.com/karmada-io/karmada/pkg/resourceinterpreter/customizedinterpreter/webhook"
+)
+
+// abandonedInstanceGracePeriod bounds how long call waits for a timed-out invocation to actually
+// return before closing its instance anyway. The wait itself is not optional: closing an instance
+// out from under a goroutine that is still mid-Call races the instance's teardown against that
+// call on the CGO-backed wasmer backend, which is a use-after-free that can crash the process
+// rather than just fail the one request. A module wedged past the grace period (e.g. stuck in an
+// infinite host-import loop with nothing left to preempt it) leaks the instance and its goroutine,
+// which is the lesser evil.
+const abandonedInstanceGracePeriod = 30 * time.Second
+
+// instancePoolSize bounds how many ready instances moduleCache keeps around per module. Interpret
+// calls beyond this bound still succeed, they just pay for a fresh Instantiate.
+const instancePoolSize = 8
+
+// defaultFuelPoints is the fuel ceiling applied when a module's resolved FuelLimit is zero, i.e.
+// it declares fuelLimit: 0. Modules that don't declare Limits.FuelLimit at all get
+// defaultFuelLimit from resolveModuleLimits instead. Backends without metering support (see
+// wazeroInstance) ignore it.
+const defaultFuelPoints = uint64(1_000_000_000)
+
+// cachedModule is a module compiled once via the configured Runtime, together with a pool of
+// instances ready to be invoked, shared across all interpret() calls that resolve to the same
+// content hash.
+type cachedModule struct {
+	module Module
+
+	mu        sync.Mutex
+	instances []Instance
+}
+
+func newCachedModule(ctx context.Context, runtime Runtime, wasmBytes []byte) (*cachedModule, error) {
+	module, err := runtime.Compile(ctx, wasmBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &cachedModule{module: module}, nil
+}
+
+// acquire returns a ready instance, instantiating a new one if the pool is empty.
+func (c *cachedModule) acquire(ctx context.Context) (Instance, error) {
+	c.mu.Lock()
+	if n := len(c.instances); n > 0 {
+		instance := c.instances[n-1]
+		c.instances = c.instances[:n-1]
+		c.mu.Unlock()
+		return instance, nil
+	}
+	c.mu.Unlock()
+
+	return c.module.Instantiate(ctx)
+}
+
+// release returns an instance to the pool, dropping it if the pool is already full.
+func (c *cachedModule) release(instance Instance) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.instances) >= instancePoolSize {
+		go instance.Close(context.Background()) //nolint:errcheck
+		return
+	}
+	c.instances = append(c.instances, instance)
+}
+
+// call invokes name's Interpreter export on a pooled instance, honoring ctx's deadline and the
+// module's fuel ceiling. A timed-out or fuel-exhausted instance is closed rather than returned to
+// the pool, since there is no way to know what state a forcibly-abandoned WASM call left it in.
+func (c *cachedModule) call(ctx context.Context, name string, attributes *webhook.RequestAttributes, limits moduleLimits) (*webhook.ResponseAttributes, error) {
+	instance, err := c.acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fuelLimit := limits.FuelLimit
+	if fuelLimit == 0 {
+		fuelLimit = defaultFuelPoints
+	}
+	instance.SetFuel(fuelLimit)
+
+	if limits.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, limits.Timeout)
+		defer cancel()
+	}
+
+	done := make(chan callOutcome, 1)
+	go func() {
+		resp, err := callInterpreter(ctx, instance, attributes)
+		done <- callOutcome{resp, err}
+	}()
+
+	select {
+	case <-ctx.Done():
+		interpreterTimeoutsTotal.WithLabelValues(name).Inc()
+		// The goroutine above may still be mid-Call on instance; wait for it to actually return
+		// before closing, rather than racing Close against it. See abandonedInstanceGracePeriod.
+		go closeAbandonedInstance(instance, done)
+		return nil, ErrInterpreterTimeout
+	case o := <-done:
+		if instance.FuelExhausted() {
+			interpreterOutOfFuelTotal.WithLabelValues(name).Inc()
+			instance.Close(context.Background()) //nolint:errcheck
+			return nil, ErrInterpreterOutOfFuel
+		}
+		c.release(instance)
+		return o.resp, o.err
+	}
+}
+
+// callOutcome carries callInterpreter's result across the goroutine boundary in cachedModule.call.
+type callOutcome struct {
+	resp *webhook.ResponseAttributes
+	err  error
+}
+
+// closeAbandonedInstance waits for a timed-out call's goroutine to deliver its outcome on done,
+// bounded by abandonedInstanceGracePeriod, before closing instance.
+func closeAbandonedInstance(instance Instance, done <-chan callOutcome) {
+	select {
+	case <-done:
+	case <-time.After(abandonedInstanceGracePeriod):
+		klog.Warningf("wasm instance call did not return %s after its timeout fired; closing it anyway", abandonedInstanceGracePeriod)
+	}
+	instance.Close(context.Background()) //nolint:errcheck
+}
+
+// moduleCache compiles each distinct module (keyed by the SHA-256 of its bytes) at most once,
+// using the WasmInterpreter's configured Runtime, and keeps a pool of ready instances around for
+// subsequent interpret() calls.
+type moduleCache struct {
+	runtime Runtime
+
+	mu      sync.Mutex
+	entries map[string]*cachedModule
+}
+
+func newModuleCache(runtime Runtime) *moduleCache {
+	return &moduleCache{runtime: runtime, entries: map[string]*cachedModule{}}
+}
+
+// supportsFuelMetering reports whether the configured Runtime enforces SetFuel/FuelExhausted.
+func (c *moduleCache) supportsFuelMetering() bool {
+	return c.runtime.SupportsFuelMetering()
+}
+
+// getOrCompile returns the cached module for hash, compiling wasmBytes if this is the first time
+// hash has been seen.
+func (c *moduleCache) getOrCompile(ctx context.Context, hash string, wasmBytes []byte) (*cachedModule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := c.entries[hash]; ok {
+		return entry, nil
+	}
+
+	entry, err := newCachedModule(ctx, c.runtime, wasmBytes)
+	if err != nil {
+		return nil, err
+	}
+	c.entries[hash] = entry
+	return entry, nil
+}
+
+// retain evicts every cached entry whose hash is not in live, which is called after each config
+// registry refresh so that modules dropped from the CRD (or superseded by a new revision of the
+// same module) don't pin compiled code and pooled instances forever.
+func (c *moduleCache) retain(live map[string]struct{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for hash := range c.entries {
+		if _, ok := live[hash]; !ok {
+			klog.V(4).Infof("evicting cached wasm module %s", hash)
+			delete(c.entries, hash)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package wasminterpreter
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/klog/v2"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+)
+
+// strategicMergePatchTypes maps the GVKs karmada knows the strategic-merge metadata for. CRDs,
+// and any built-in kind not listed here, fall back to a plain JSON merge patch.
+var strategicMergePatchTypes = map[schema.GroupVersionKind]interface{}{
+	corev1.SchemeGroupVersion.WithKind("Pod"):         &corev1.Pod{},
+	appsv1.SchemeGroupVersion.WithKind("Deployment"):  &appsv1.Deployment{},
+	appsv1.SchemeGroupVersion.WithKind("StatefulSet"): &appsv1.StatefulSet{},
+	appsv1.SchemeGroupVersion.WithKind("DaemonSet"):   &appsv1.DaemonSet{},
+	batchv1.SchemeGroupVersion.WithKind("Job"):        &batchv1.Job{},
+}
+
+// applyPatch uses patchType to patch object.
+func applyPatch(object *unstructured.Unstructured, patch []byte, patchType configv1alpha1.PatchType) (*unstructured.Unstructured, error) {
+	if len(patch) == 0 && len(patchType) == 0 {
+		klog.Infof("Skip apply patch for object(%s: %s) as patch and patchType is nil", object.GroupVersionKind().String(), object.GetName())
+		return object, nil
+	}
+	if len(patch) == 0 {
+		return object, nil
+	}
+
+	switch patchType {
+	case configv1alpha1.PatchTypeJSONPatch:
+		return applyJSONPatch(object, patch)
+	case configv1alpha1.PatchTypeMergePatch:
+		return applyMergePatch(object, patch)
+	case configv1alpha1.PatchTypeStrategicMergePatch:
+		return applyStrategicMergePatch(object, patch)
+	default:
+		return nil, fmt.Errorf("return patch type %s is not support", patchType)
+	}
+}
+
+func applyJSONPatch(object *unstructured.Unstructured, patch []byte) (*unstructured.Unstructured, error) {
+	patchObj, err := jsonpatch.DecodePatch(patch)
+	if err != nil {
+		return nil, err
+	}
+	if len(patchObj) == 0 {
+		return object, nil
+	}
+
+	objectJSONBytes, err := object.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	patchedObjectJSONBytes, err := patchObj.Apply(objectJSONBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	err = object.UnmarshalJSON(patchedObjectJSONBytes)
+	return object, err
+}
+
+func applyMergePatch(object *unstructured.Unstructured, patch []byte) (*unstructured.Unstructured, error) {
+	objectJSONBytes, err := object.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	patchedObjectJSONBytes, err := jsonpatch.MergePatch(objectJSONBytes, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = object.UnmarshalJSON(patchedObjectJSONBytes)
+	return object, err
+}
+
+func applyStrategicMergePatch(object *unstructured.Unstructured, patch []byte) (*unstructured.Unstructured, error) {
+	objectJSONBytes, err := object.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	var patchedObjectJSONBytes []byte
+	if dataStruct, ok := strategicMergePatchTypes[object.GroupVersionKind()]; ok {
+		lookupPatchMeta, err := strategicpatch.NewPatchMetaFromStruct(dataStruct)
+		if err != nil {
+			return nil, err
+		}
+		patchedObjectJSONBytes, err = strategicpatch.StrategicMergePatchUsingLookupPatchMeta(objectJSONBytes, patch, lookupPatchMeta)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// Karmada doesn't carry strategic-merge metadata for CRDs, so fall back to a plain JSON
+		// merge patch; this matches kubectl's behavior for resources without defined merge keys.
+		patchedObjectJSONBytes, err = jsonpatch.MergePatch(objectJSONBytes, patch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = object.UnmarshalJSON(patchedObjectJSONBytes)
+	return object, err
+}
@@ -0,0 +1,164 @@
+package wasminterpreter
+
+import (
+	"encoding/json"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	configv1alpha1 "github.com/karmada-io/karmada/pkg/apis/config/v1alpha1"
+)
+
+func unstructuredFromJSON(t *testing.T, raw string) *unstructured.Unstructured {
+	t.Helper()
+	obj := &unstructured.Unstructured{}
+	if err := obj.UnmarshalJSON([]byte(raw)); err != nil {
+		t.Fatalf("unmarshal fixture: %v", err)
+	}
+	return obj
+}
+
+func TestApplyPatch(t *testing.T) {
+	deployment := `{
+		"apiVersion": "apps/v1",
+		"kind": "Deployment",
+		"metadata": {"name": "nginx"},
+		"spec": {"replicas": 1, "template": {"spec": {"containers": [{"name": "nginx", "image": "nginx:1.19"}]}}}
+	}`
+	customResource := `{
+		"apiVersion": "example.karmada.io/v1alpha1",
+		"kind": "Widget",
+		"metadata": {"name": "gadget"},
+		"spec": {"color": "red", "size": 1}
+	}`
+
+	tests := []struct {
+		name      string
+		object    string
+		patch     []byte
+		patchType configv1alpha1.PatchType
+		wantSpec  map[string]interface{}
+	}{
+		{
+			name:      "JSONPatch on Deployment",
+			object:    deployment,
+			patchType: configv1alpha1.PatchTypeJSONPatch,
+			patch:     []byte(`[{"op":"replace","path":"/spec/replicas","value":3}]`),
+			wantSpec:  map[string]interface{}{"replicas": int64(3)},
+		},
+		{
+			name:      "MergePatch on Deployment",
+			object:    deployment,
+			patchType: configv1alpha1.PatchTypeMergePatch,
+			patch:     []byte(`{"spec":{"replicas":5}}`),
+			wantSpec:  map[string]interface{}{"replicas": int64(5)},
+		},
+		{
+			name:      "StrategicMergePatch on Deployment uses built-in merge metadata",
+			object:    deployment,
+			patchType: configv1alpha1.PatchTypeStrategicMergePatch,
+			patch:     []byte(`{"spec":{"replicas":7}}`),
+			wantSpec:  map[string]interface{}{"replicas": int64(7)},
+		},
+		{
+			name:      "JSONPatch on custom resource",
+			object:    customResource,
+			patchType: configv1alpha1.PatchTypeJSONPatch,
+			patch:     []byte(`[{"op":"replace","path":"/spec/color","value":"blue"}]`),
+			wantSpec:  map[string]interface{}{"color": "blue", "size": int64(1)},
+		},
+		{
+			name:      "MergePatch on custom resource",
+			object:    customResource,
+			patchType: configv1alpha1.PatchTypeMergePatch,
+			patch:     []byte(`{"spec":{"color":"green"}}`),
+			wantSpec:  map[string]interface{}{"color": "green", "size": int64(1)},
+		},
+		{
+			name:      "StrategicMergePatch on custom resource falls back to merge patch",
+			object:    customResource,
+			patchType: configv1alpha1.PatchTypeStrategicMergePatch,
+			patch:     []byte(`{"spec":{"color":"yellow"}}`),
+			wantSpec:  map[string]interface{}{"color": "yellow", "size": int64(1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			object := unstructuredFromJSON(t, tt.object)
+
+			got, err := applyPatch(object, tt.patch, tt.patchType)
+			if err != nil {
+				t.Fatalf("applyPatch() error = %v", err)
+			}
+
+			spec, ok := got.Object["spec"].(map[string]interface{})
+			if !ok {
+				t.Fatalf("patched object has no spec: %#v", got.Object)
+			}
+			for k, want := range tt.wantSpec {
+				if spec[k] != want {
+					t.Errorf("spec[%q] = %v (%T), want %v (%T)", k, spec[k], spec[k], want, want)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyPatch_EmptyFastPaths(t *testing.T) {
+	object := unstructuredFromJSON(t, `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"nginx"},"spec":{"replicas":1}}`)
+
+	t.Run("nil patch and patch type returns object unchanged", func(t *testing.T) {
+		got, err := applyPatch(object, nil, "")
+		if err != nil {
+			t.Fatalf("applyPatch() error = %v", err)
+		}
+		if got != object {
+			t.Errorf("expected the same object instance back, got a different one")
+		}
+	})
+
+	t.Run("empty patch with a patch type set returns object unchanged", func(t *testing.T) {
+		got, err := applyPatch(object, nil, configv1alpha1.PatchTypeMergePatch)
+		if err != nil {
+			t.Fatalf("applyPatch() error = %v", err)
+		}
+		if got != object {
+			t.Errorf("expected the same object instance back, got a different one")
+		}
+	})
+
+	t.Run("empty JSON patch document is a no-op", func(t *testing.T) {
+		got, err := applyPatch(object, []byte(`[]`), configv1alpha1.PatchTypeJSONPatch)
+		if err != nil {
+			t.Fatalf("applyPatch() error = %v", err)
+		}
+		if got != object {
+			t.Errorf("expected the same object instance back, got a different one")
+		}
+	})
+
+	t.Run("unknown patch type is rejected", func(t *testing.T) {
+		if _, err := applyPatch(object, []byte(`{}`), configv1alpha1.PatchType("bogus")); err == nil {
+			t.Errorf("expected an error for an unsupported patch type")
+		}
+	})
+}
+
+func TestApplyPatch_ResultIsValidJSON(t *testing.T) {
+	object := unstructuredFromJSON(t, `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"nginx"},"spec":{"replicas":1}}`)
+
+	got, err := applyPatch(object, []byte(`{"spec":{"replicas":2}}`), configv1alpha1.PatchTypeMergePatch)
+	if err != nil {
+		t.Fatalf("applyPatch() error = %v", err)
+	}
+
+	data, err := got.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON() error = %v", err)
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("patched object is not valid JSON: %v", err)
+	}
+}
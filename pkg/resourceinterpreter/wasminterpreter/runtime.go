@@ -0,0 +1,63 @@
+package wasminterpreter
+
+import "context"
+
+// Runtime abstracts the underlying WASM engine so WasmInterpreter isn't hard-wired to a specific
+// implementation. The original implementation depended directly on wasmer-go, which requires CGO
+// and complicates cross-compiling karmada-controller-manager; wazeroRuntime is a pure-Go
+// alternative that avoids that cost.
+type Runtime interface {
+	// Compile parses and validates wasmBytes, returning a Module that can be instantiated
+	// repeatedly without re-parsing.
+	Compile(ctx context.Context, wasmBytes []byte) (Module, error)
+
+	// SupportsFuelMetering reports whether instances produced by this Runtime enforce the fuel
+	// ceiling passed to Instance.SetFuel. Backends that return false still accept SetFuel calls
+	// (they're no-ops) and FuelExhausted always reports false on them, so a module's only
+	// protection against a runaway loop on such a backend is the wall-clock timeout.
+	SupportsFuelMetering() bool
+}
+
+// Module is a compiled WASM module.
+type Module interface {
+	// Instantiate creates a fresh Instance backed by this module, with its own linear memory.
+	Instantiate(ctx context.Context) (Instance, error)
+
+	// Close releases resources held by the compiled module.
+	Close(ctx context.Context) error
+}
+
+// Instance is a single instantiation of a Module.
+type Instance interface {
+	// Call invokes the exported function fn with the given arguments and returns its results.
+	Call(ctx context.Context, fn string, args ...uint64) ([]uint64, error)
+
+	// Memory returns the instance's exported linear memory, or nil if it doesn't export one.
+	Memory() Memory
+
+	// SetFuel resets the instance's remaining instruction budget ahead of a call. Backends that
+	// don't support metering may treat this as a no-op.
+	SetFuel(points uint64)
+
+	// FuelExhausted reports whether the most recent Call trapped due to the budget set by
+	// SetFuel. Always false on backends that don't support metering.
+	FuelExhausted() bool
+
+	// Close releases resources held by the instance.
+	Close(ctx context.Context) error
+}
+
+// Memory is an instance's linear memory.
+type Memory interface {
+	// Read returns a copy of the length bytes starting at offset, or false if the range is out
+	// of bounds.
+	Read(offset, length uint32) ([]byte, bool)
+
+	// Write copies data into memory starting at offset, or returns false if the range is out of
+	// bounds.
+	Write(offset uint32, data []byte) bool
+}
+
+// RuntimeFactory constructs a Runtime. NewWasmInterpreter accepts one so tests can inject a fake
+// runtime instead of depending on an actual WASM engine.
+type RuntimeFactory func() (Runtime, error)
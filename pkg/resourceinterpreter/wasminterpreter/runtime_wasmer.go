@@ -0,0 +1,153 @@
+//go:build wasmer_runtime
+
+package wasminterpreter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/wasmerio/wasmer-go/wasmer"
+)
+
+// defaultMeteringPoints seeds the metering middleware at compile time; the ceiling actually
+// enforced for a given call is the module's resolved moduleLimits.FuelLimit (its declared limit, or
+// defaultFuelLimit when it declares none), reset via SetFuel before every invocation.
+const defaultMeteringPoints = uint64(1_000_000_000)
+
+// NewWasmerRuntime constructs the CGO-based Runtime backend. It requires linking against
+// libwasmer and is only compiled in when building with `-tags wasmer_runtime`; it remains
+// available for deployments that need wasmer's JIT performance and are able to pay the CGO cost.
+func NewWasmerRuntime() RuntimeFactory {
+	return func() (Runtime, error) {
+		return &wasmerRuntime{}, nil
+	}
+}
+
+type wasmerRuntime struct{}
+
+// SupportsFuelMetering always returns true: Compile installs wasmer's metering middleware on
+// every module, so SetFuel/FuelExhausted are fully backed by the engine.
+func (wasmerRuntime) SupportsFuelMetering() bool { return true }
+
+func (wasmerRuntime) Compile(_ context.Context, wasmBytes []byte) (Module, error) {
+	metering := wasmer.NewMetering(defaultMeteringPoints, func(wasmer.Operator) uint64 { return 1 })
+	config := wasmer.NewConfig().PushMiddleware(metering)
+	engine := wasmer.NewEngineWithConfig(config)
+	store := wasmer.NewStore(engine)
+
+	module, err := wasmer.NewModule(store, wasmBytes)
+	if err != nil {
+		return nil, err
+	}
+	return &wasmerModule{store: store, module: module}, nil
+}
+
+type wasmerModule struct {
+	store  *wasmer.Store
+	module *wasmer.Module
+}
+
+func (m *wasmerModule) Instantiate(context.Context) (Instance, error) {
+	wasiEnv, err := wasmer.NewWasiStateBuilder("wasi-program").Finalize()
+	if err != nil {
+		return nil, err
+	}
+	importObject, err := wasiEnv.GenerateImportObject(m.store, m.module)
+	if err != nil {
+		return nil, err
+	}
+	instance, err := wasmer.NewInstance(m.module, importObject)
+	if err != nil {
+		return nil, err
+	}
+	return &wasmerInstance{store: m.store, instance: instance}, nil
+}
+
+func (m *wasmerModule) Close(context.Context) error {
+	m.module.Close()
+	return nil
+}
+
+type wasmerInstance struct {
+	store    *wasmer.Store
+	instance *wasmer.Instance
+
+	fuelExhausted bool
+}
+
+func (i *wasmerInstance) Call(_ context.Context, fn string, args ...uint64) ([]uint64, error) {
+	exported, err := i.instance.Exports.GetFunction(fn)
+	if err != nil {
+		return nil, fmt.Errorf("module does not export %q: %w", fn, err)
+	}
+
+	wasmerArgs := make([]interface{}, len(args))
+	for idx, arg := range args {
+		wasmerArgs[idx] = int64(arg)
+	}
+
+	result, callErr := exported(wasmerArgs...)
+
+	// Query remaining points unconditionally: a fuel-exhausted call traps, which surfaces here as
+	// callErr rather than a normal result, but the instance is still fuelExhausted and callers
+	// (cachedModule.call) rely on FuelExhausted() to tell that apart from an ordinary module error.
+	points := wasmer.GetRemainingPoints(i.store, i.instance)
+	i.fuelExhausted = points.Exhausted
+
+	if callErr != nil {
+		return nil, callErr
+	}
+
+	switch v := result.(type) {
+	case nil:
+		return nil, nil
+	case int64:
+		return []uint64{uint64(v)}, nil
+	case int32:
+		return []uint64{uint64(uint32(v))}, nil
+	default:
+		return nil, fmt.Errorf("unexpected result type %T from %q", result, fn)
+	}
+}
+
+func (i *wasmerInstance) Memory() Memory {
+	mem, err := i.instance.Exports.GetMemory("memory")
+	if err != nil {
+		return nil
+	}
+	return wasmerMemory{mem: mem}
+}
+
+func (i *wasmerInstance) SetFuel(points uint64) {
+	wasmer.SetRemainingPoints(i.store, i.instance, points)
+}
+
+func (i *wasmerInstance) FuelExhausted() bool { return i.fuelExhausted }
+
+func (i *wasmerInstance) Close(context.Context) error {
+	i.instance.Close()
+	return nil
+}
+
+type wasmerMemory struct {
+	mem *wasmer.Memory
+}
+
+func (m wasmerMemory) Read(offset, length uint32) ([]byte, bool) {
+	data := m.mem.Data()
+	if uint64(offset)+uint64(length) > uint64(len(data)) {
+		return nil, false
+	}
+	out := make([]byte, length)
+	copy(out, data[offset:offset+length])
+	return out, true
+}
+
+func (m wasmerMemory) Write(offset uint32, data []byte) bool {
+	dst := m.mem.Data()
+	if uint64(offset)+uint64(len(data)) > uint64(len(dst)) {
+		return false
+	}
+	copy(dst[offset:], data)
+	return true
+}
@@ -0,0 +1,98 @@
+// Package sdk is the guest-side half of the WASM interpreter ABI implemented by
+// pkg/resourceinterpreter/wasminterpreter (see abi.go there for the host side). Modules are built
+// against this package with:
+//
+//	tinygo build -target=wasi -o module.wasm
+//
+// A module registers one handler per InterpreterOperation it implements via Register, and leaves
+// exporting alloc/dealloc/Interpreter to this package.
+package sdk
+
+import (
+	"encoding/json"
+	"unsafe"
+)
+
+// Handler is a module's implementation of a single InterpreterOperation.
+type Handler func(req *RequestAttributes) (*ResponseAttributes, error)
+
+var handlers = map[InterpreterOperation]Handler{}
+
+// Register associates a Handler with the InterpreterOperation it implements. Call it from the
+// module's init() for every operation the module supports.
+func Register(op InterpreterOperation, h Handler) {
+	handlers[op] = h
+}
+
+// liveBuffers retains a reference to every buffer handed out by alloc, keyed by its address, so
+// that TinyGo's GC cannot reclaim it between the host's alloc call and the subsequent Interpreter
+// (or dealloc) call that actually uses it: the address returned to the host is just a uint32, and
+// nothing else on the guest side holds the slice alive. dealloc removes the entry once the host is
+// done with it.
+var liveBuffers = map[uint32][]byte{}
+
+// alloc reserves size bytes in linear memory and returns the address, so the host can copy the
+// JSON-encoded request into the guest before calling Interpreter.
+//
+//export alloc
+func alloc(size uint32) uint32 {
+	buf := make([]byte, size)
+	ptr := uint32(uintptr(unsafe.Pointer(&buf[0])))
+	liveBuffers[ptr] = buf
+	return ptr
+}
+
+// dealloc releases the buffer alloc returned at ptr, allowing the GC to reclaim it. The host calls
+// this once it has finished reading (or writing and handing off) the buffer.
+//
+//export dealloc
+func dealloc(ptr uint32, _ uint32) {
+	delete(liveBuffers, ptr)
+}
+
+// Interpreter is the module's single entry point. It decodes the request at [ptr, ptr+size) in
+// linear memory, dispatches to the handler registered for the request's Operation, and returns a
+// packed (respPtr<<32 | respLen) pointing at the JSON-encoded response, itself allocated via
+// alloc so the host can read it back out before calling dealloc.
+//
+//export Interpreter
+func Interpreter(ptr uint32, size uint32) uint64 {
+	data := readMemory(ptr, size)
+
+	var req RequestAttributes
+	if err := json.Unmarshal(data, &req); err != nil {
+		return writeResponse(&ResponseAttributes{Error: "decode request: " + err.Error()})
+	}
+
+	handler, ok := handlers[req.Operation]
+	if !ok {
+		return writeResponse(&ResponseAttributes{Error: "no handler registered for operation " + string(req.Operation)})
+	}
+
+	resp, err := handler(&req)
+	if err != nil {
+		return writeResponse(&ResponseAttributes{Error: err.Error()})
+	}
+	return writeResponse(resp)
+}
+
+func readMemory(ptr, size uint32) []byte {
+	return unsafe.Slice((*byte)(unsafe.Pointer(uintptr(ptr))), size)
+}
+
+// writeResponse JSON-encodes resp into a freshly allocated buffer and packs its address/length
+// into the i64 the host expects back from Interpreter.
+func writeResponse(resp *ResponseAttributes) uint64 {
+	data, err := json.Marshal(resp)
+	if err != nil {
+		// Marshaling our own response type failing means the SDK itself is broken; there is no
+		// sensible recovery, so report an empty response rather than panic across the ABI.
+		data = []byte(`{}`)
+	}
+
+	ptr := alloc(uint32(len(data)))
+	dst := readMemory(ptr, uint32(len(data)))
+	copy(dst, data)
+
+	return uint64(ptr)<<32 | uint64(len(data))
+}
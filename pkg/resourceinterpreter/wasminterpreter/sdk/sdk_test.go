@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"encoding/json"
+	"testing"
+	"unsafe"
+)
+
+// putRequest encodes req and copies it into a freshly alloc'd buffer, mimicking what the host does
+// before calling Interpreter: alloc, then write the JSON request at the returned address.
+func putRequest(t *testing.T, req *RequestAttributes) (ptr, size uint32) {
+	t.Helper()
+
+	data, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("marshal request: %v", err)
+	}
+
+	ptr = alloc(uint32(len(data)))
+	dst := unsafe.Slice((*byte)(unsafe.Pointer(uintptr(ptr))), len(data))
+	copy(dst, data)
+	return ptr, uint32(len(data))
+}
+
+func TestInterpreterRoundTrip(t *testing.T) {
+	Register(OperationInterpretReplica, func(req *RequestAttributes) (*ResponseAttributes, error) {
+		return &ResponseAttributes{Replicas: 3}, nil
+	})
+
+	ptr, size := putRequest(t, &RequestAttributes{Operation: OperationInterpretReplica})
+
+	packed := Interpreter(ptr, size)
+	dealloc(ptr, size)
+
+	respPtr, respSize := uint32(packed>>32), uint32(packed)
+	respData := unsafe.Slice((*byte)(unsafe.Pointer(uintptr(respPtr))), respSize)
+
+	var resp ResponseAttributes
+	if err := json.Unmarshal(respData, &resp); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	dealloc(respPtr, respSize)
+
+	if resp.Replicas != 3 {
+		t.Errorf("resp.Replicas = %d, want 3", resp.Replicas)
+	}
+}
+
+func TestInterpreterUnregisteredOperation(t *testing.T) {
+	ptr, size := putRequest(t, &RequestAttributes{Operation: OperationPatch})
+
+	packed := Interpreter(ptr, size)
+	dealloc(ptr, size)
+
+	respPtr, respSize := uint32(packed>>32), uint32(packed)
+	respData := unsafe.Slice((*byte)(unsafe.Pointer(uintptr(respPtr))), respSize)
+
+	var resp ResponseAttributes
+	if err := json.Unmarshal(respData, &resp); err != nil {
+		t.Fatalf("unmarshal response: %v", err)
+	}
+	dealloc(respPtr, respSize)
+
+	if resp.Error == "" {
+		t.Error("resp.Error is empty, want a message for an unregistered operation")
+	}
+}
+
+// TestAllocPinsBuffer checks that alloc records its buffer in liveBuffers, which keeps the backing
+// array reachable for the GC until dealloc releases it, that the buffer reads back what was written
+// to it, and that dealloc removes the entry. It does not force a GC cycle itself.
+func TestAllocPinsBuffer(t *testing.T) {
+	ptr := alloc(4)
+	dst := unsafe.Slice((*byte)(unsafe.Pointer(uintptr(ptr))), 4)
+	copy(dst, []byte{1, 2, 3, 4})
+
+	if _, ok := liveBuffers[ptr]; !ok {
+		t.Fatal("alloc did not retain the buffer in liveBuffers")
+	}
+
+	if got := unsafe.Slice((*byte)(unsafe.Pointer(uintptr(ptr))), 4); got[0] != 1 || got[3] != 4 {
+		t.Fatalf("buffer contents changed unexpectedly: %v", got)
+	}
+
+	dealloc(ptr, 4)
+	if _, ok := liveBuffers[ptr]; ok {
+		t.Fatal("dealloc did not remove the buffer from liveBuffers")
+	}
+}
@@ -0,0 +1,57 @@
+package sdk
+
+// InterpreterOperation mirrors configv1alpha1.InterpreterOperation. It is redeclared here rather
+// than imported so that guest modules, which are compiled standalone with tinygo, don't need to
+// pull in the host's Kubernetes dependency graph.
+type InterpreterOperation string
+
+// These values must stay in sync with the InterpreterOperation* constants in
+// pkg/apis/config/v1alpha1.
+const (
+	OperationInterpretReplica    InterpreterOperation = "InterpretReplica"
+	OperationPatch               InterpreterOperation = "Patch"
+	OperationInterpretDependency InterpreterOperation = "Dependencies"
+	OperationReflectStatus       InterpreterOperation = "ReflectStatus"
+	OperationInterpretHealth     InterpreterOperation = "InterpretHealth"
+)
+
+// RequestAttributes is the guest-side counterpart of
+// pkg/resourceinterpreter/customizedinterpreter/webhook.RequestAttributes. Only the fields a
+// module actually needs cross the ABI, so this is kept as plain JSON-tagged data rather than a
+// shared Go type.
+type RequestAttributes struct {
+	Operation        InterpreterOperation   `json:"operation"`
+	GVK              GroupVersionKind       `json:"gvk"`
+	Object           map[string]interface{} `json:"object,omitempty"`
+	OldObject        map[string]interface{} `json:"oldObject,omitempty"`
+	DesiredReplicas  *int32                 `json:"desiredReplicas,omitempty"`
+	AggregatedStatus []byte                 `json:"aggregatedStatus,omitempty"`
+}
+
+// GroupVersionKind mirrors k8s.io/apimachinery/pkg/runtime/schema.GroupVersionKind.
+type GroupVersionKind struct {
+	Group   string `json:"group"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+}
+
+// ResponseAttributes is the guest-side counterpart of
+// pkg/resourceinterpreter/customizedinterpreter/webhook.ResponseAttributes.
+type ResponseAttributes struct {
+	Replicas            int32                      `json:"replicas,omitempty"`
+	ReplicaRequirements map[string]interface{}     `json:"replicaRequirements,omitempty"`
+	Patch               []byte                     `json:"patch,omitempty"`
+	PatchType           string                     `json:"patchType,omitempty"`
+	Dependencies        []DependentObjectReference `json:"dependencies,omitempty"`
+	RawStatus           []byte                     `json:"rawStatus,omitempty"`
+	Healthy             bool                       `json:"healthy,omitempty"`
+	Error               string                     `json:"error,omitempty"`
+}
+
+// DependentObjectReference mirrors configv1alpha1.DependentObjectReference.
+type DependentObjectReference struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"time"
 
-	jsonpatch "github.com/evanphx/json-patch/v5"
-	"github.com/wasmerio/wasmer-go/wasmer"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -19,13 +19,38 @@ import (
 	"github.com/karmada-io/karmada/pkg/util/fedinformer/genericmanager"
 )
 
-// WasmInterpreter interpret custom resource with webhook configuration.
+// moduleFetchTimeout bounds how long fetchModuleBytes waits for a module source to respond. It
+// exists so that a slow or non-responding URL can never hang the caller indefinitely; refresh()
+// relies on this to keep the registry responsive to new configuration even when a module source
+// is unreachable.
+const moduleFetchTimeout = 10 * time.Second
+
+// moduleFetchClient is shared across fetchModuleBytes calls; its Timeout backs ctx's deadline in
+// case the caller passes a context with no deadline of its own.
+var moduleFetchClient = &http.Client{Timeout: moduleFetchTimeout}
+
+// WasmInterpreter interpret custom resource with a ResourceInterpreterWasmConfiguration.
 type WasmInterpreter struct {
+	// configManager is a webhook client manager set up with the same authentication and service
+	// resolvers as the webhook-backed interpreter. Module sources are not fetched through it:
+	// fetchModuleBytes uses moduleFetchClient, which sends no credentials.
 	configManager *webhookutil.ClientManager
+
+	// registry indexes the modules declared by ResourceInterpreterWasmConfiguration objects in
+	// the cluster, kept up to date by watching the CRD through the informer manager.
+	registry *configRegistry
+
+	// cache holds compiled modules and pooled instances keyed by content hash, so repeated
+	// interpret() calls for the same module skip recompilation.
+	cache *moduleCache
 }
 
-// NewWasmInterpreter return a new CustomizedInterpreter.
-func NewWasmInterpreter(informer genericmanager.SingleClusterInformerManager) (*WasmInterpreter, error) {
+// NewWasmInterpreter return a new WasmInterpreter that discovers modules by watching
+// ResourceInterpreterWasmConfiguration objects via informer. factory selects the WASM runtime
+// backend; passing nil defaults to the pure-Go wazero backend so that
+// karmada-controller-manager stays CGO-free. Pass NewWasmerRuntime() (requires building with
+// `-tags wasmer_runtime`) to opt into wasmer-go instead.
+func NewWasmInterpreter(informer genericmanager.SingleClusterInformerManager, factory RuntimeFactory) (*WasmInterpreter, error) {
 	cm, err := webhookutil.NewClientManager(
 		[]schema.GroupVersion{configv1alpha1.SchemeGroupVersion},
 		configv1alpha1.AddToScheme,
@@ -40,14 +65,28 @@ func NewWasmInterpreter(informer genericmanager.SingleClusterInformerManager) (*
 	cm.SetAuthenticationInfoResolver(authInfoResolver)
 	cm.SetServiceResolver(webhookutil.NewDefaultServiceResolver())
 
+	if factory == nil {
+		factory = NewWazeroRuntime()
+	}
+	rt, err := factory()
+	if err != nil {
+		return nil, fmt.Errorf("construct wasm runtime: %w", err)
+	}
+	if !rt.SupportsFuelMetering() {
+		klog.Warningf("the configured wasm runtime does not support fuel metering; modules can only be bounded by their wall-clock timeout")
+	}
+
+	cache := newModuleCache(rt)
 	return &WasmInterpreter{
 		configManager: &cm,
+		registry:      newConfigRegistry(informer, cache),
+		cache:         cache,
 	}, nil
 }
 
 // HookEnabled tells if any hook exist for specific resource gvk and operation type.
 func (e *WasmInterpreter) HookEnabled(objGVK schema.GroupVersionKind, operation configv1alpha1.InterpreterOperation) bool {
-	return true
+	return e.registry.enabled(objGVK, operation)
 }
 
 // GetReplicas returns the desired replicas of the object as well as the requirements of each replica.
@@ -84,89 +123,51 @@ func (e *WasmInterpreter) Patch(ctx context.Context, attributes *webhook.Request
 }
 
 func (e *WasmInterpreter) interpret(ctx context.Context, attributes *webhook.RequestAttributes) (*webhook.ResponseAttributes, bool, error) {
-	wasmBytes, err := ioutil.ReadFile("/etc/karmada/interpreter.wasm")
-	if err != nil {
-		klog.Errorf("load wasmer module err: %v", err)
-		return nil, false, nil
-	}
-
-	engine := wasmer.NewEngine()
-	store := wasmer.NewStore(engine)
-
-	// Compiles the module
-	module, err := wasmer.NewModule(store, wasmBytes)
-	if err != nil {
-		klog.Errorf("new wasmer module err: %v", err)
+	mod, matched := e.registry.lookup(attributes.GVK, attributes.Operation)
+	if !matched {
 		return nil, false, nil
 	}
 
-	wasiEnv, _ := wasmer.NewWasiStateBuilder("wasi-program").Finalize()
-
-	// Instantiates the module
-	importObject, err := wasiEnv.GenerateImportObject(store, module)
-	if err != nil {
-		klog.Errorf("generate wasmer objecer err: %v", err)
-		return nil, false, nil
-	}
-	instance, err := wasmer.NewInstance(module, importObject)
+	cached, err := e.cache.getOrCompile(ctx, mod.hash, mod.bytes)
 	if err != nil {
-		klog.Errorf("new wasmer instance err: %v", err)
+		klog.Errorf("compile wasm module %q err: %v", mod.name, err)
 		return nil, false, nil
 	}
 
-	// Gets the `sum` exported function from the WebAssembly instance.
-	fn, err := instance.Exports.GetFunction("Interpreter")
+	attrs, err := cached.call(ctx, mod.name, attributes, mod.limits)
 	if err != nil {
-		klog.Errorf("get wasmer module err: %v", err)
-		return nil, false, nil
-	}
-
-	// Calls that exported function with Go standard values. The WebAssembly
-	// types are inferred and values are casted automatically.
-	result, _ := fn(attributes)
-	attrs, ok := result.(*webhook.ResponseAttributes)
-	if !ok {
+		// A timed-out or fuel-exhausted call produced no trustworthy result; log it and treat
+		// the hook as unmatched rather than propagating garbage.
+		klog.Errorf("call module %q err: %v", mod.name, err)
 		return nil, false, nil
 	}
 
 	klog.V(2).Infof("interpret results: %v", attrs)
 
-	return attrs, false, nil
+	return attrs, true, nil
 }
 
-// applyPatch uses patchType mode to patch object.
-func applyPatch(object *unstructured.Unstructured, patch []byte, patchType configv1alpha1.PatchType) (*unstructured.Unstructured, error) {
-	if len(patch) == 0 && len(patchType) == 0 {
-		klog.Infof("Skip apply patch for object(%s: %s) as patch and patchType is nil", object.GroupVersionKind().String(), object.GetName())
-		return object, nil
-	}
-	switch patchType {
-	case configv1alpha1.PatchTypeJSONPatch:
-		if len(patch) == 0 {
-			return object, nil
-		}
-		patchObj, err := jsonpatch.DecodePatch(patch)
-		if err != nil {
-			return nil, err
-		}
-		if len(patchObj) == 0 {
-			return object, nil
-		}
-
-		objectJSONBytes, err := object.MarshalJSON()
-		if err != nil {
-			return nil, err
-		}
-		patchedObjectJSONBytes, err := patchObj.Apply(objectJSONBytes)
-		if err != nil {
-			return nil, err
-		}
-
-		err = object.UnmarshalJSON(patchedObjectJSONBytes)
-		return object, err
-	default:
-		return nil, fmt.Errorf("return patch type %s is not support", patchType)
+// fetchModuleBytes resolves a module's bytes from the source declared on its
+// ResourceInterpreterWasmConfiguration module entry. ctx bounds the fetch; callers should attach a
+// deadline of their own (refresh does) rather than relying solely on moduleFetchClient's timeout.
+func fetchModuleBytes(ctx context.Context, source configv1alpha1.WasmModuleSource) ([]byte, error) {
+	if source.URL == nil {
+		return nil, fmt.Errorf("module source has no URL set")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, *source.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request for module %s: %w", *source.URL, err)
+	}
+	resp, err := moduleFetchClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching module from %s: unexpected status %s", *source.URL, resp.Status)
 	}
+	return ioutil.ReadAll(resp.Body)
 }
 
 // GetDependencies returns the dependencies of give object.
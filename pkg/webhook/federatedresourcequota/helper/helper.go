@@ -134,3 +134,57 @@ var integerResources = sets.NewString(
 func IsIntegerResourceName(str string) bool {
 	return integerResources.Has(str) || IsExtendedResourceName(corev1.ResourceName(str))
 }
+
+// The federated and workspace quota helpers below are karmada-specific and are not lifted from the
+// Kubernetes codebase.
+
+const (
+	// FederatedQuotaResourceRequestsPrefix is the prefix FederatedResourceQuota uses to expose the
+	// cross-cluster aggregate of a native quota resource, e.g. "federated.karmada.io/requests.cpu".
+	FederatedQuotaResourceRequestsPrefix = "federated.karmada.io/requests."
+
+	// WorkspaceQuotaResourcePrefix is the prefix used for resources scoped to a karmada workspace
+	// rather than a single member cluster, e.g. "workspace.karmada.io/pods".
+	WorkspaceQuotaResourcePrefix = "workspace.karmada.io/"
+)
+
+var standardFederatedQuotaResources = sets.NewString(
+	FederatedQuotaResourceRequestsPrefix+string(corev1.ResourceCPU),
+	FederatedQuotaResourceRequestsPrefix+string(corev1.ResourceMemory),
+	FederatedQuotaResourceRequestsPrefix+string(corev1.ResourceEphemeralStorage),
+	FederatedQuotaResourceRequestsPrefix+string(corev1.ResourceStorage),
+	FederatedQuotaResourceRequestsPrefix+string(corev1.ResourcePods),
+)
+
+// IsFederatedQuotaResourceName returns true if the resource name is one FederatedResourceQuota
+// tracks as a cross-cluster aggregate, i.e. it is in standardFederatedQuotaResources or carries the
+// FederatedQuotaResourceRequestsPrefix.
+func IsFederatedQuotaResourceName(name corev1.ResourceName) bool {
+	return standardFederatedQuotaResources.Has(string(name)) || strings.HasPrefix(string(name), FederatedQuotaResourceRequestsPrefix)
+}
+
+// IsWorkspaceQuotaResourceName returns true if the resource name is scoped to a karmada workspace
+// rather than a single member cluster.
+func IsWorkspaceQuotaResourceName(name corev1.ResourceName) bool {
+	return strings.HasPrefix(string(name), WorkspaceQuotaResourcePrefix)
+}
+
+// FederatedResourceName returns the federated counterpart of a native quota resource name, e.g.
+// "cpu" becomes "federated.karmada.io/requests.cpu". Names that are already federated or
+// workspace-scoped are returned unchanged.
+func FederatedResourceName(name corev1.ResourceName) corev1.ResourceName {
+	if IsFederatedQuotaResourceName(name) || IsWorkspaceQuotaResourceName(name) {
+		return name
+	}
+	return corev1.ResourceName(FederatedQuotaResourceRequestsPrefix + string(name))
+}
+
+// NativeResourceName returns the native resource name underlying a federated quota resource name,
+// e.g. "federated.karmada.io/requests.cpu" becomes "cpu". Names that are not federated are
+// returned unchanged.
+func NativeResourceName(name corev1.ResourceName) corev1.ResourceName {
+	if !strings.HasPrefix(string(name), FederatedQuotaResourceRequestsPrefix) {
+		return name
+	}
+	return corev1.ResourceName(strings.TrimPrefix(string(name), FederatedQuotaResourceRequestsPrefix))
+}